@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType identifies one of the status conditions carried by a
+// NodeNetworkConfigurationPolicy or NodeNetworkConfigurationEnactment.
+type ConditionType string
+
+// ConditionReason is the machine-readable reason backing a Condition's
+// current Status.
+type ConditionReason string
+
+// Condition mirrors the standard Kubernetes condition shape: a Type/Status
+// pair plus the Reason/Message explaining it and the time Status last
+// changed.
+type Condition struct {
+	Type               ConditionType          `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	Reason             ConditionReason        `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime,omitempty"`
+}
+
+// ConditionList is the Conditions field carried by policy and enactment
+// status: a small set of Condition keyed by Type.
+type ConditionList []Condition
+
+// Find returns the condition of conditionType, or nil if it hasn't been set
+// yet. Callers must nil-check before reading Status/Reason/Message.
+func (conditions ConditionList) Find(conditionType ConditionType) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// Set creates or updates the condition of conditionType. LastTransitionTime
+// only moves forward when Status actually changes, not on every call, so
+// callers can tell how long a condition has held its current status (see
+// policyconditions' progress-deadline handling).
+func (conditions *ConditionList) Set(conditionType ConditionType, status corev1.ConditionStatus, reason ConditionReason, message string) {
+	existing := conditions.Find(conditionType)
+	if existing == nil {
+		*conditions = append(*conditions, Condition{
+			Type:               conditionType,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: metav1.NewTime(time.Now()),
+		})
+		return
+	}
+	if existing.Status != status {
+		existing.LastTransitionTime = metav1.NewTime(time.Now())
+	}
+	existing.Status = status
+	existing.Reason = reason
+	existing.Message = message
+}
+
+// Policy condition types.
+const (
+	NodeNetworkConfigurationPolicyConditionAvailable   ConditionType = "Available"
+	NodeNetworkConfigurationPolicyConditionDegraded    ConditionType = "Degraded"
+	NodeNetworkConfigurationPolicyConditionProgressing ConditionType = "Progressing"
+)
+
+// Policy condition reasons.
+const (
+	NodeNetworkConfigurationPolicyConditionConfigurationProgressing    ConditionReason = "ConfigurationProgressing"
+	NodeNetworkConfigurationPolicyConditionSuccessfullyConfigured      ConditionReason = "SuccessfullyConfigured"
+	NodeNetworkConfigurationPolicyConditionConfigurationNoMatchingNode ConditionReason = "NoMatchingNode"
+	NodeNetworkConfigurationPolicyConditionFailedToConfigure           ConditionReason = "FailedToConfigure"
+	NodeNetworkConfigurationPolicyConditionPartiallyApplied            ConditionReason = "PartiallyApplied"
+	NodeNetworkConfigurationPolicyConditionUninstalling                ConditionReason = "Uninstalling"
+)
+
+// Enactment condition types.
+const (
+	NodeNetworkConfigurationEnactmentConditionAvailable ConditionType = "Available"
+	NodeNetworkConfigurationEnactmentConditionFailing   ConditionType = "Failing"
+	NodeNetworkConfigurationEnactmentConditionAborted   ConditionType = "Aborted"
+)
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ConditionList) DeepCopyInto(out *ConditionList) {
+	if in == nil {
+		*out = nil
+		return
+	}
+	*out = make(ConditionList, len(in))
+	copy(*out, in)
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new ConditionList.
+func (in ConditionList) DeepCopy() ConditionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionList)
+	in.DeepCopyInto(out)
+	return *out
+}
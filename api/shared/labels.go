@@ -0,0 +1,23 @@
+package shared
+
+const (
+	// EnactmentPolicyLabel names the NodeNetworkConfigurationPolicy a
+	// NodeNetworkConfigurationEnactment was created for.
+	EnactmentPolicyLabel = "nmstate.io/policy"
+
+	// EnactmentNodeLabel names the node a NodeNetworkConfigurationEnactment
+	// reports on.
+	EnactmentNodeLabel = "nmstate.io/node"
+)
+
+const (
+	// NodeNetworkConfigurationPolicyFinalizer is added to every policy so it
+	// can't be removed from the apiserver before its enactments have been
+	// cleaned up.
+	NodeNetworkConfigurationPolicyFinalizer = "nmstate.io/policy-cleanup"
+
+	// NodeNetworkConfigurationEnactmentFinalizer is added to every enactment
+	// so it can't be removed from the apiserver before the handler has had a
+	// chance to revert its desired state.
+	NodeNetworkConfigurationEnactmentFinalizer = "nmstate.io/enactment-cleanup"
+)
@@ -0,0 +1,43 @@
+package shared
+
+// State carries the desired or observed network configuration in NMState
+// YAML format. It is kept opaque here - the handler interprets it, the
+// operator just stores and compares it - so it deep-copies as a plain byte
+// slice instead of a parsed structure.
+type State struct {
+	Raw []byte `json:"-"`
+}
+
+// MarshalJSON treats the raw YAML as an already-encoded JSON value, the same
+// way runtime.RawExtension does.
+func (s State) MarshalJSON() ([]byte, error) {
+	if len(s.Raw) == 0 {
+		return []byte("null"), nil
+	}
+	return s.Raw, nil
+}
+
+// UnmarshalJSON stores data as-is without attempting to parse it.
+func (s *State) UnmarshalJSON(data []byte) error {
+	s.Raw = append(s.Raw[0:0], data...)
+	return nil
+}
+
+// DeepCopyInto is a deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *State) DeepCopyInto(out *State) {
+	*out = *in
+	if in.Raw != nil {
+		out.Raw = make([]byte, len(in.Raw))
+		copy(out.Raw, in.Raw)
+	}
+}
+
+// DeepCopy is a deepcopy function, copying the receiver, creating a new State.
+func (in *State) DeepCopy() *State {
+	if in == nil {
+		return nil
+	}
+	out := new(State)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,59 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nmstate "github.com/nmstate/kubernetes-nmstate/api/shared"
+)
+
+// NodeNetworkConfigurationPolicySpec defines the desired state of
+// NodeNetworkConfigurationPolicy
+type NodeNetworkConfigurationPolicySpec struct {
+	// NodeSelector is a selector which must be true for the policy to be
+	// applied to the node. Selector which must match a node's labels for
+	// the policy to be applied to that node.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// The desired configuration of the policy
+	// +optional
+	DesiredState nmstate.State `json:"desiredState,omitempty"`
+
+	// ProgressDeadlineSeconds is the number of seconds a policy can stay
+	// Progressing, counted from when its rollout started, before it is
+	// reported as PartiallyApplied instead. Omitted or non-positive
+	// values keep today's behaviour of never timing out a rollout.
+	// +optional
+	ProgressDeadlineSeconds *int64 `json:"progressDeadlineSeconds,omitempty"`
+}
+
+// NodeNetworkConfigurationPolicyStatus defines the observed state of
+// NodeNetworkConfigurationPolicy
+type NodeNetworkConfigurationPolicyStatus struct {
+	// +optional
+	Conditions nmstate.ConditionList `json:"conditions,omitempty" optional:"true"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nodenetworkconfigurationpolicies,scope=Cluster,shortName=nncp
+
+// NodeNetworkConfigurationPolicy is the Schema for the
+// nodenetworkconfigurationpolicies API
+type NodeNetworkConfigurationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeNetworkConfigurationPolicySpec   `json:"spec,omitempty"`
+	Status NodeNetworkConfigurationPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeNetworkConfigurationPolicyList contains a list of
+// NodeNetworkConfigurationPolicy
+type NodeNetworkConfigurationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeNetworkConfigurationPolicy `json:"items"`
+}
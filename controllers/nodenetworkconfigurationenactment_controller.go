@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	nmstate "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+	"github.com/nmstate/kubernetes-nmstate/pkg/policyconditions"
+)
+
+// EnactmentStatusController watches NodeNetworkConfigurationEnactment
+// objects. A single policy can own hundreds of these, one per node, so a
+// rollout touches every one of them in a short burst. Instead of calling
+// policyconditions.Update synchronously on every single change - which
+// would mean a full policy recomputation and its own conflict-retry loop
+// per node - it enqueues the owning policy key onto a shared
+// policyconditions.Updater, which coalesces the burst into one
+// Get+List+Status.Update.
+type EnactmentStatusController struct {
+	client.Client
+	updater *policyconditions.Updater
+}
+
+// NewEnactmentStatusController builds an EnactmentStatusController backed
+// by its own policyconditions.Updater. SetupWithManager starts that
+// Updater's worker alongside the controller.
+func NewEnactmentStatusController(cli client.Client, recorder record.EventRecorder) *EnactmentStatusController {
+	return &EnactmentStatusController{
+		Client:  cli,
+		updater: policyconditions.NewUpdater(cli, recorder),
+	}
+}
+
+func (r *EnactmentStatusController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	enactment := &nmstatev1beta1.NodeNetworkConfigurationEnactment{}
+	if err := r.Get(ctx, req.NamespacedName, enactment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	policyName, hasPolicy := enactment.Labels[nmstate.EnactmentPolicyLabel]
+	if !hasPolicy {
+		return ctrl.Result{}, nil
+	}
+
+	r.updater.Enqueue(types.NamespacedName{Name: policyName})
+	return ctrl.Result{}, nil
+}
+
+func (r *EnactmentStatusController) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		r.updater.Start(ctx.Done())
+		<-ctx.Done()
+		return nil
+	})); err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nmstatev1beta1.NodeNetworkConfigurationEnactment{}).
+		Complete(r)
+}
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+	"github.com/nmstate/kubernetes-nmstate/pkg/policyconditions"
+)
+
+// PolicyController reacts to NodeNetworkConfigurationPolicy changes: a
+// policy being created or edited is a single event, so its conditions are
+// recomputed synchronously here via policyconditions.Update. Per-node
+// enactment status changes recompute the same conditions too, but those
+// arrive in bursts and go through policyconditions.Updater's Enqueue instead - see
+// EnactmentStatusController.
+type PolicyController struct {
+	client.Client
+	recorder record.EventRecorder
+}
+
+// NewPolicyController builds a PolicyController. recorder is used to emit
+// the policy condition transition Events handled by policyconditions.
+func NewPolicyController(cli client.Client, recorder record.EventRecorder) *PolicyController {
+	return &PolicyController{Client: cli, recorder: recorder}
+}
+
+func (r *PolicyController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	policy := &nmstatev1beta1.NodeNetworkConfigurationPolicy{}
+	if err := r.Get(ctx, req.NamespacedName, policy); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !policy.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, policyconditions.Reset(r.Client, req.NamespacedName)
+	}
+
+	return ctrl.Result{}, policyconditions.Update(r.Client, r.recorder, req.NamespacedName)
+}
+
+func (r *PolicyController) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&nmstatev1beta1.NodeNetworkConfigurationPolicy{}).
+		Complete(r)
+}
@@ -0,0 +1,62 @@
+package policyconditions
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+
+	nmstate "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+)
+
+// NodeBreakdown buckets the nodes targeted by a policy by the phase their
+// enactment is currently in, so a stuck node doesn't hide how the rest of
+// the fleet is doing.
+type NodeBreakdown struct {
+	Available []string
+	Failed    []string
+	Aborted   []string
+	Pending   []string
+}
+
+func nodeEnactmentPhase(enactment nmstatev1beta1.NodeNetworkConfigurationEnactment, generation int64) string {
+	if enactment.Status.Generation() != generation {
+		return "Pending"
+	}
+	if aborted := enactment.Status.Conditions.Find(nmstate.NodeNetworkConfigurationEnactmentConditionAborted); aborted != nil && aborted.Status == corev1.ConditionTrue {
+		return "Aborted"
+	}
+	if failing := enactment.Status.Conditions.Find(nmstate.NodeNetworkConfigurationEnactmentConditionFailing); failing != nil && failing.Status == corev1.ConditionTrue {
+		return "Failed"
+	}
+	if available := enactment.Status.Conditions.Find(nmstate.NodeNetworkConfigurationEnactmentConditionAvailable); available != nil && available.Status == corev1.ConditionTrue {
+		return "Available"
+	}
+	return "Pending"
+}
+
+// NewNodeBreakdown buckets the node name of every enactment under
+// Available, Failed, Aborted or still Pending, so partial rollout status
+// can be reported node by node. An enactment whose status is for a
+// generation other than generation counts as Pending.
+func NewNodeBreakdown(enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, generation int64) NodeBreakdown {
+	breakdown := NodeBreakdown{}
+	for _, enactment := range enactments.Items {
+		nodeName := enactment.Labels[nmstate.EnactmentNodeLabel]
+		switch nodeEnactmentPhase(enactment, generation) {
+		case "Available":
+			breakdown.Available = append(breakdown.Available, nodeName)
+		case "Failed":
+			breakdown.Failed = append(breakdown.Failed, nodeName)
+		case "Aborted":
+			breakdown.Aborted = append(breakdown.Aborted, nodeName)
+		default:
+			breakdown.Pending = append(breakdown.Pending, nodeName)
+		}
+	}
+	sort.Strings(breakdown.Available)
+	sort.Strings(breakdown.Failed)
+	sort.Strings(breakdown.Aborted)
+	sort.Strings(breakdown.Pending)
+	return breakdown
+}
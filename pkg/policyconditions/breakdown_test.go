@@ -0,0 +1,20 @@
+package policyconditions
+
+import (
+	"testing"
+
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+)
+
+// TestNodeEnactmentPhaseWithoutConditionsIsPending guards against the panic
+// fixed in nodeEnactmentPhase: Find returns nil for a condition that hasn't
+// been set yet, and that nil must be checked before reading Status.
+func TestNodeEnactmentPhaseWithoutConditionsIsPending(t *testing.T) {
+	enactment := nmstatev1beta1.NodeNetworkConfigurationEnactment{}
+
+	phase := nodeEnactmentPhase(enactment, enactment.Status.Generation())
+
+	if phase != "Pending" {
+		t.Fatalf("expected Pending for an enactment with no conditions set yet, got %q", phase)
+	}
+}
@@ -0,0 +1,40 @@
+package policyconditions
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	nmstate "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+)
+
+func recordPolicyEvent(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, eventType, reason, message string) {
+	if recorder == nil {
+		return
+	}
+	recorder.Event(policy, eventType, reason, message)
+}
+
+// failingEnactmentsSummary renders one "<node>: <message>" entry per failed
+// or aborted enactment matching generation, so the event attached to the
+// policy names the nodes that are actually broken instead of just a count.
+func failingEnactmentsSummary(enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, generation int64) string {
+	var reasons []string
+	for _, enactment := range enactments.Items {
+		phase := nodeEnactmentPhase(enactment, generation)
+		if phase != "Failed" && phase != "Aborted" {
+			continue
+		}
+		nodeName := enactment.Labels[nmstate.EnactmentNodeLabel]
+		failing := enactment.Status.Conditions.Find(nmstate.NodeNetworkConfigurationEnactmentConditionFailing)
+		message := ""
+		if failing != nil {
+			message = failing.Message
+		}
+		reasons = append(reasons, fmt.Sprintf("%s: %s", nodeName, message))
+	}
+	return strings.Join(reasons, "; ")
+}
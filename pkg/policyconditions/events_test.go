@@ -0,0 +1,42 @@
+package policyconditions
+
+import (
+	"testing"
+
+	"k8s.io/client-go/tools/record"
+
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+)
+
+func TestRecordPolicyEventIsNoopWithoutRecorder(t *testing.T) {
+	policy := &nmstatev1beta1.NodeNetworkConfigurationPolicy{}
+
+	// Must not panic: Update is called with a nil recorder whenever a
+	// caller doesn't care about Events, e.g. tests that only assert on the
+	// resulting conditions.
+	recordPolicyEvent(nil, policy, "Normal", "SuccessfullyConfigured", "done")
+}
+
+func TestRecordPolicyEventEmitsThroughRecorder(t *testing.T) {
+	policy := &nmstatev1beta1.NodeNetworkConfigurationPolicy{}
+	recorder := record.NewFakeRecorder(1)
+
+	recordPolicyEvent(recorder, policy, "Normal", "SuccessfullyConfigured", "done")
+
+	select {
+	case event := <-recorder.Events:
+		if event != "Normal SuccessfullyConfigured done" {
+			t.Fatalf("unexpected event: %q", event)
+		}
+	default:
+		t.Fatal("expected an event to be recorded")
+	}
+}
+
+func TestFailingEnactmentsSummaryIsEmptyWithoutEnactments(t *testing.T) {
+	enactments := nmstatev1beta1.NodeNetworkConfigurationEnactmentList{}
+
+	if summary := failingEnactmentsSummary(enactments, 0); summary != "" {
+		t.Fatalf("expected no summary without any enactments, got %q", summary)
+	}
+}
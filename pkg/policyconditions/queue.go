@@ -0,0 +1,77 @@
+package policyconditions
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Updater coalesces bursts of policy condition recomputations behind a
+// rate-limited workqueue keyed by policy name. Enactment controllers call
+// Enqueue instead of Update directly, so hundreds of enactment status
+// changes for the same policy collapse into a single worker-driven
+// Get+List+Status.Update instead of each one running its own
+// conflict-retry loop against the apiserver.
+type Updater struct {
+	cli      client.Client
+	recorder record.EventRecorder
+	queue    workqueue.RateLimitingInterface
+}
+
+// NewUpdater builds an Updater backed by client-go's default controller
+// rate limiter (per-item exponential backoff on repeated failures plus an
+// overall token bucket). Failed items are requeued with no retry cap.
+func NewUpdater(cli client.Client, recorder record.EventRecorder) *Updater {
+	return &Updater{
+		cli:      cli,
+		recorder: recorder,
+		queue:    workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+}
+
+// Enqueue schedules a recomputation of policyKey's conditions. Repeated
+// enqueues for the same policy while it is already queued collapse into a
+// single run, which is what coalesces bursts of enactment updates.
+func (u *Updater) Enqueue(policyKey types.NamespacedName) {
+	u.queue.Add(policyKey)
+}
+
+// Start runs the single coalescing worker until stopCh is closed. The
+// worker spends most of its time parked in queue.Get() waiting for work, so
+// stopCh is watched by a separate goroutine that shuts the queue down -
+// that is what actually unblocks Get() and lets the worker exit.
+func (u *Updater) Start(stopCh <-chan struct{}) {
+	go func() {
+		<-stopCh
+		u.ShutDown()
+	}()
+	go u.runWorker()
+}
+
+// ShutDown stops accepting new work and unblocks the worker goroutine.
+func (u *Updater) ShutDown() {
+	u.queue.ShutDown()
+}
+
+func (u *Updater) runWorker() {
+	for u.processNextItem() {
+	}
+}
+
+func (u *Updater) processNextItem() bool {
+	item, shutdown := u.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer u.queue.Done(item)
+
+	policyKey := item.(types.NamespacedName)
+	if err := Update(u.cli, u.recorder, policyKey); err != nil {
+		log.Error(err, "failed to update policy conditions, backing off and requeueing", "policy", policyKey.Name)
+		u.queue.AddRateLimited(item)
+		return true
+	}
+	u.queue.Forget(item)
+	return true
+}
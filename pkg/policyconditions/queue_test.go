@@ -0,0 +1,29 @@
+package policyconditions
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestEnqueueCoalescesRepeatedAddsForTheSamePolicy(t *testing.T) {
+	updater := NewUpdater(nil, nil)
+	key := types.NamespacedName{Name: "policy1"}
+
+	updater.Enqueue(key)
+	updater.Enqueue(key)
+	updater.Enqueue(key)
+
+	if n := updater.queue.Len(); n != 1 {
+		t.Fatalf("expected repeated Enqueue calls for the same policy to collapse into one queued item, got %d", n)
+	}
+}
+
+func TestProcessNextItemStopsOnceShutDown(t *testing.T) {
+	updater := NewUpdater(nil, nil)
+	updater.ShutDown()
+
+	if updater.processNextItem() {
+		t.Fatal("expected processNextItem to report no more work once shut down")
+	}
+}
@@ -0,0 +1,112 @@
+package policyconditions
+
+import (
+	"context"
+	"os"
+
+	"github.com/pkg/errors"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/types"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	nmstate "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+	enactmentconditions "github.com/nmstate/kubernetes-nmstate/pkg/enactmentstatus/conditions"
+)
+
+// UninstallingAnnotationKey, set to "true" on a NodeNetworkConfigurationPolicy
+// or on the handler Deployment, tells policyconditions to stop reconciling
+// status as usual and instead drain the policy so a wrapping operator can
+// delete it without it getting stuck.
+const UninstallingAnnotationKey = "nmstate.io/uninstalling"
+
+// handlerDeploymentNameEnvVar and handlerDeploymentNamespaceEnvVar let the
+// handler's own Deployment manifest tell policyconditions where to find
+// itself. Wrapping operators (e.g. CNAO) don't all install the handler into
+// the same namespace, so this can't be a hardcoded constant.
+const (
+	handlerDeploymentNameEnvVar      = "HANDLER_DEPLOYMENT_NAME"
+	handlerDeploymentNamespaceEnvVar = "POD_NAMESPACE"
+)
+
+const (
+	defaultHandlerDeploymentName      = "nmstate-handler"
+	defaultHandlerDeploymentNamespace = "nmstate"
+)
+
+// handlerDeploymentKey locates the operator Deployment that also carries the
+// uninstalling annotation when a wrapping operator tears down
+// kubernetes-nmstate as one of its operands.
+func handlerDeploymentKey() types.NamespacedName {
+	name := os.Getenv(handlerDeploymentNameEnvVar)
+	if name == "" {
+		name = defaultHandlerDeploymentName
+	}
+	namespace := os.Getenv(handlerDeploymentNamespaceEnvVar)
+	if namespace == "" {
+		namespace = defaultHandlerDeploymentNamespace
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}
+}
+
+func isUninstallingAnnotated(annotations map[string]string) bool {
+	return annotations[UninstallingAnnotationKey] == "true"
+}
+
+func isUninstalling(cli client.Client, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy) bool {
+	if isUninstallingAnnotated(policy.Annotations) {
+		return true
+	}
+	deployment := &appsv1.Deployment{}
+	if err := cli.Get(context.TODO(), handlerDeploymentKey(), deployment); err != nil {
+		return false
+	}
+	return isUninstallingAnnotated(deployment.Annotations)
+}
+
+func withoutFinalizer(finalizers []string, finalizer string) []string {
+	kept := finalizers[:0]
+	for _, f := range finalizers {
+		if f != finalizer {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// uninstall marks every enactment of policy as Aborted, strips the nmstate
+// finalizer from the policy and its enactments and sets a terminal
+// Uninstalling condition on the policy. It does a single best-effort pass
+// instead of retrying on conflict, since on uninstall the goal is to
+// unblock deletion rather than to keep status perfectly accurate.
+func uninstall(cli client.Client, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList) error {
+	for i := range enactments.Items {
+		enactment := &enactments.Items[i]
+
+		enactmentconditions.SetAborted(&enactment.Status.Conditions, "uninstalling kubernetes-nmstate")
+		if err := cli.Status().Update(context.TODO(), enactment); err != nil {
+			return errors.Wrap(err, "aborting enactment failed")
+		}
+
+		enactment.Finalizers = withoutFinalizer(enactment.Finalizers, nmstate.NodeNetworkConfigurationEnactmentFinalizer)
+		if err := cli.Update(context.TODO(), enactment); err != nil {
+			return errors.Wrap(err, "removing enactment finalizer failed")
+		}
+	}
+
+	// Set the terminal condition before removing the policy's own finalizer:
+	// once that finalizer is gone the apiserver is free to garbage-collect
+	// the policy, and a status update racing that deletion would just fail
+	// with NotFound instead of recording why the policy went away.
+	SetPolicyUninstalling(policy, "kubernetes-nmstate is uninstalling, enactments were aborted and finalizers removed")
+	if err := cli.Status().Update(context.TODO(), policy); err != nil {
+		return errors.Wrap(err, "setting policy uninstalling condition failed")
+	}
+
+	policy.Finalizers = withoutFinalizer(policy.Finalizers, nmstate.NodeNetworkConfigurationPolicyFinalizer)
+	if err := cli.Update(context.TODO(), policy); err != nil {
+		return errors.Wrap(err, "removing policy finalizer failed")
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package policyconditions
+
+import "testing"
+
+func TestWithoutFinalizerRemovesOnlyTheNamedFinalizer(t *testing.T) {
+	finalizers := []string{"a.example.com/a", "nmstate.io/policy-cleanup", "b.example.com/b"}
+
+	got := withoutFinalizer(finalizers, "nmstate.io/policy-cleanup")
+
+	want := []string{"a.example.com/a", "b.example.com/b"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestWithoutFinalizerIsNoopWhenFinalizerIsAbsent(t *testing.T) {
+	finalizers := []string{"a.example.com/a", "b.example.com/b"}
+
+	got := withoutFinalizer(finalizers, "nmstate.io/policy-cleanup")
+
+	if len(got) != len(finalizers) {
+		t.Fatalf("expected finalizers to be unchanged, got %v", got)
+	}
+}
+
+func TestIsUninstallingAnnotated(t *testing.T) {
+	cases := []struct {
+		name        string
+		annotations map[string]string
+		want        bool
+	}{
+		{"no annotations", nil, false},
+		{"annotated false", map[string]string{UninstallingAnnotationKey: "false"}, false},
+		{"annotated true", map[string]string{UninstallingAnnotationKey: "true"}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isUninstallingAnnotated(c.annotations); got != c.want {
+				t.Fatalf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
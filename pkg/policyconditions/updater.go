@@ -0,0 +1,247 @@
+package policyconditions
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+
+	nmstate "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+	enactmentconditions "github.com/nmstate/kubernetes-nmstate/pkg/enactmentstatus/conditions"
+)
+
+// PolicyConditionUpdater decides and sets the conditions a
+// NodeNetworkConfigurationPolicy should carry for a given enactment
+// snapshot. It exists so a wrapping operator (for example one that installs
+// kubernetes-nmstate as an operand and wants to layer its own conditions,
+// such as Upgradeable or degraded-because-operator-paused) can inject
+// additional condition types and reason strings without forking the
+// handler, by passing its own implementation to Update via
+// WithPolicyConditionUpdater.
+type PolicyConditionUpdater interface {
+	SetProgressing(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string)
+	SetSuccess(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string)
+	SetFailed(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, message string)
+	SetNotMatching(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string)
+
+	// Compute inspects policy, its enactments and the number of nodes
+	// running kubernetes-nmstate, and sets the resulting conditions on
+	// policy via the Set* methods above. It is the single entry point
+	// Update calls once per reconcile.
+	Compute(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, numberOfNmstateNodes int)
+}
+
+// DefaultPolicyConditionUpdater is the PolicyConditionUpdater used when
+// Update is not given one explicitly. It reproduces kubernetes-nmstate's
+// own behaviour: Progressing/PartiallyApplied while nodes are still
+// finishing, then NotMatching/Failed/Success once they are all done.
+type DefaultPolicyConditionUpdater struct{}
+
+func (DefaultPolicyConditionUpdater) SetProgressing(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string) {
+	log.Info("SetPolicyProgressing")
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionDegraded,
+		corev1.ConditionUnknown,
+		nmstate.NodeNetworkConfigurationPolicyConditionConfigurationProgressing,
+		"",
+	)
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionAvailable,
+		corev1.ConditionUnknown,
+		nmstate.NodeNetworkConfigurationPolicyConditionConfigurationProgressing,
+		message,
+	)
+	recordPolicyEvent(recorder, policy, corev1.EventTypeNormal, string(nmstate.NodeNetworkConfigurationPolicyConditionConfigurationProgressing), message)
+}
+
+func (DefaultPolicyConditionUpdater) SetSuccess(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string) {
+	log.Info("SetPolicySuccess")
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionDegraded,
+		corev1.ConditionFalse,
+		nmstate.NodeNetworkConfigurationPolicyConditionSuccessfullyConfigured,
+		"",
+	)
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionAvailable,
+		corev1.ConditionTrue,
+		nmstate.NodeNetworkConfigurationPolicyConditionSuccessfullyConfigured,
+		message,
+	)
+	recordPolicyEvent(recorder, policy, corev1.EventTypeNormal, string(nmstate.NodeNetworkConfigurationPolicyConditionSuccessfullyConfigured), message)
+}
+
+func (DefaultPolicyConditionUpdater) SetNotMatching(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string) {
+	log.Info("SetPolicyNotMatching")
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionDegraded,
+		corev1.ConditionFalse,
+		nmstate.NodeNetworkConfigurationPolicyConditionConfigurationNoMatchingNode,
+		message,
+	)
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionAvailable,
+		corev1.ConditionTrue,
+		nmstate.NodeNetworkConfigurationPolicyConditionConfigurationNoMatchingNode,
+		message,
+	)
+	recordPolicyEvent(recorder, policy, corev1.EventTypeNormal, string(nmstate.NodeNetworkConfigurationPolicyConditionConfigurationNoMatchingNode), message)
+}
+
+func (DefaultPolicyConditionUpdater) SetFailed(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, message string) {
+	log.Info("SetPolicyFailedToConfigure")
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionDegraded,
+		corev1.ConditionTrue,
+		nmstate.NodeNetworkConfigurationPolicyConditionFailedToConfigure,
+		message,
+	)
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionAvailable,
+		corev1.ConditionFalse,
+		nmstate.NodeNetworkConfigurationPolicyConditionFailedToConfigure,
+		"",
+	)
+	eventMessage := message
+	if summary := failingEnactmentsSummary(enactments, policy.Generation); summary != "" {
+		eventMessage = fmt.Sprintf("%s (%s)", message, summary)
+	}
+	recordPolicyEvent(recorder, policy, corev1.EventTypeWarning, string(nmstate.NodeNetworkConfigurationPolicyConditionFailedToConfigure), eventMessage)
+}
+
+// SetPartiallyApplied is not part of PolicyConditionUpdater: it is specific
+// to DefaultPolicyConditionUpdater's own progress-deadline handling, called
+// from Compute.
+func (DefaultPolicyConditionUpdater) SetPartiallyApplied(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string) {
+	log.Info("SetPolicyPartiallyApplied")
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionDegraded,
+		corev1.ConditionTrue,
+		nmstate.NodeNetworkConfigurationPolicyConditionPartiallyApplied,
+		message,
+	)
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionAvailable,
+		corev1.ConditionUnknown,
+		nmstate.NodeNetworkConfigurationPolicyConditionPartiallyApplied,
+		message,
+	)
+	recordPolicyEvent(recorder, policy, corev1.EventTypeWarning, string(nmstate.NodeNetworkConfigurationPolicyConditionPartiallyApplied), message)
+}
+
+// progressingSince is tracked through a dedicated condition type instead of
+// reusing Available's LastTransitionTime: both SetProgressing and
+// SetPartiallyApplied overwrite Available's reason, so relying on
+// Available's own reason/time to detect "still the same rollout" made the
+// deadline check flip-flop between the two every reconcile once exceeded
+// (each reconcile "forgot" the deadline was already exceeded). This marker
+// condition is only touched by markProgressing/clearProgressing below, so
+// its LastTransitionTime stays pinned to when the current rollout started.
+func markProgressing(policy *nmstatev1beta1.NodeNetworkConfigurationPolicy) {
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionProgressing,
+		corev1.ConditionTrue,
+		nmstate.NodeNetworkConfigurationPolicyConditionConfigurationProgressing,
+		"",
+	)
+}
+
+func clearProgressing(policy *nmstatev1beta1.NodeNetworkConfigurationPolicy) {
+	policy.Status.Conditions.Set(
+		nmstate.NodeNetworkConfigurationPolicyConditionProgressing,
+		corev1.ConditionFalse,
+		"",
+		"",
+	)
+}
+
+func progressDeadlineExceeded(policy *nmstatev1beta1.NodeNetworkConfigurationPolicy) bool {
+	deadlineSeconds := policy.Spec.ProgressDeadlineSeconds
+	if deadlineSeconds == nil || *deadlineSeconds <= 0 {
+		return false
+	}
+	progressingSince := policy.Status.Conditions.Find(nmstate.NodeNetworkConfigurationPolicyConditionProgressing)
+	if progressingSince == nil || progressingSince.Status != corev1.ConditionTrue {
+		return false
+	}
+	deadline := progressingSince.LastTransitionTime.Add(time.Duration(*deadlineSeconds) * time.Second)
+	return time.Now().After(deadline)
+}
+
+func (u DefaultPolicyConditionUpdater) Compute(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, numberOfNmstateNodes int) {
+	enactmentsCount := enactmentconditions.Count(enactments, policy.Generation)
+	numberOfFinishedEnactments := enactmentsCount.Available() + enactmentsCount.Failed() + enactmentsCount.NotMatching() + enactmentsCount.Aborted()
+
+	log.Info(fmt.Sprintf("enactments count: %s", enactmentsCount))
+	if numberOfFinishedEnactments < numberOfNmstateNodes {
+		markProgressing(policy)
+		if progressDeadlineExceeded(policy) {
+			breakdown := NewNodeBreakdown(enactments, policy.Generation)
+			message := fmt.Sprintf(
+				"Policy progress deadline exceeded, %d/%d nodes finished: %d available, %d failed, %d aborted, %d pending (%v)",
+				numberOfFinishedEnactments, numberOfNmstateNodes,
+				len(breakdown.Available), len(breakdown.Failed), len(breakdown.Aborted), len(breakdown.Pending),
+				breakdown.Pending,
+			)
+			u.SetPartiallyApplied(recorder, policy, message)
+		} else {
+			u.SetProgressing(recorder, policy, fmt.Sprintf("Policy is progressing %d/%d nodes finished", numberOfFinishedEnactments, numberOfNmstateNodes))
+		}
+		return
+	}
+
+	clearProgressing(policy)
+
+	if enactmentsCount.Matching() == 0 {
+		u.SetNotMatching(recorder, policy, "Policy does not match any node")
+		return
+	}
+	if enactmentsCount.Failed() > 0 || enactmentsCount.Aborted() > 0 {
+		message := fmt.Sprintf("%d/%d nodes failed to configure", enactmentsCount.Failed(), enactmentsCount.Matching())
+		if enactmentsCount.Aborted() > 0 {
+			message += fmt.Sprintf(", %d nodes aborted configuration", enactmentsCount.Aborted())
+		}
+		u.SetFailed(recorder, policy, enactments, message)
+		return
+	}
+	u.SetSuccess(recorder, policy, fmt.Sprintf("%d/%d nodes successfully configured", enactmentsCount.Available(), enactmentsCount.Available()))
+}
+
+// CompositeUpdater fans out every call to each of Updaters in order, so the
+// same reconcile loop can drive both the NNCP status and, for example, an
+// operator-level aggregate CR that wraps kubernetes-nmstate as an operand.
+type CompositeUpdater struct {
+	Updaters []PolicyConditionUpdater
+}
+
+func (c CompositeUpdater) SetProgressing(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string) {
+	for _, updater := range c.Updaters {
+		updater.SetProgressing(recorder, policy, message)
+	}
+}
+
+func (c CompositeUpdater) SetSuccess(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string) {
+	for _, updater := range c.Updaters {
+		updater.SetSuccess(recorder, policy, message)
+	}
+}
+
+func (c CompositeUpdater) SetFailed(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, message string) {
+	for _, updater := range c.Updaters {
+		updater.SetFailed(recorder, policy, enactments, message)
+	}
+}
+
+func (c CompositeUpdater) SetNotMatching(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, message string) {
+	for _, updater := range c.Updaters {
+		updater.SetNotMatching(recorder, policy, message)
+	}
+}
+
+func (c CompositeUpdater) Compute(recorder record.EventRecorder, policy *nmstatev1beta1.NodeNetworkConfigurationPolicy, enactments nmstatev1beta1.NodeNetworkConfigurationEnactmentList, numberOfNmstateNodes int) {
+	for _, updater := range c.Updaters {
+		updater.Compute(recorder, policy, enactments, numberOfNmstateNodes)
+	}
+}
@@ -0,0 +1,43 @@
+package policyconditions
+
+import (
+	"testing"
+	"time"
+
+	nmstate "github.com/nmstate/kubernetes-nmstate/api/shared"
+	nmstatev1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+)
+
+func int64Ptr(v int64) *int64 {
+	return &v
+}
+
+func TestComputeKeepsPartiallyAppliedAcrossReconcilesPastDeadline(t *testing.T) {
+	policy := &nmstatev1beta1.NodeNetworkConfigurationPolicy{
+		Spec: nmstatev1beta1.NodeNetworkConfigurationPolicySpec{
+			ProgressDeadlineSeconds: int64Ptr(1),
+		},
+	}
+	enactments := nmstatev1beta1.NodeNetworkConfigurationEnactmentList{}
+	updater := DefaultPolicyConditionUpdater{}
+
+	// First reconcile starts the rollout: still within the deadline, so the
+	// policy should be plain Progressing.
+	updater.Compute(nil, policy, enactments, 2)
+	available := policy.Status.Conditions.Find(nmstate.NodeNetworkConfigurationPolicyConditionAvailable)
+	if available == nil || available.Reason != nmstate.NodeNetworkConfigurationPolicyConditionConfigurationProgressing {
+		t.Fatalf("expected policy to be Progressing after first reconcile, got %+v", available)
+	}
+
+	// Let the deadline elapse and reconcile twice in a row: PartiallyApplied
+	// must stick instead of flapping back to Progressing on the following
+	// reconcile.
+	time.Sleep(1100 * time.Millisecond)
+	updater.Compute(nil, policy, enactments, 2)
+	updater.Compute(nil, policy, enactments, 2)
+
+	available = policy.Status.Conditions.Find(nmstate.NodeNetworkConfigurationPolicyConditionAvailable)
+	if available == nil || available.Reason != nmstate.NodeNetworkConfigurationPolicyConditionPartiallyApplied {
+		t.Fatalf("expected policy to stay PartiallyApplied across reconciles, got %+v", available)
+	}
+}